@@ -0,0 +1,56 @@
+// Copyright © 2019 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+	"github.com/kube-logging/logging-operator/pkg/sdk/logging/api/v1beta1"
+)
+
+// destinationDriver is implemented by the syslog-ng output drivers that
+// renderDestination can render. A SyslogNGOutput with none of them set is
+// rendered as an empty destination block.
+type destinationDriver interface {
+	Render(secretLoader secret.SecretLoader, destName string) (driver string, err error)
+}
+
+func destinationName(o v1beta1.SyslogNGOutput) string {
+	return fmt.Sprintf("output_%s_%s", o.Namespace, o.Name)
+}
+
+// renderDestination renders the `destination "..." { ... };` block for a
+// single SyslogNGOutput.
+func renderDestination(o v1beta1.SyslogNGOutput, secretLoader secret.SecretLoader) (string, error) {
+	name := destinationName(o)
+
+	var driver destinationDriver
+	switch {
+	case o.Spec.Redis != nil:
+		driver = o.Spec.Redis
+	case o.Spec.MongoDB != nil:
+		driver = o.Spec.MongoDB
+	default:
+		return fmt.Sprintf("destination %q {\n\n};\n", name), nil
+	}
+
+	body, err := driver.Render(secretLoader, name)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("destination %q {\n\t%s;\n};\n", name, body), nil
+}
@@ -0,0 +1,103 @@
+// Copyright © 2019 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+	"github.com/kube-logging/logging-operator/pkg/sdk/logging/api/v1beta1"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CheckConfigForOutput renders the destination block for a single
+// SyslogNGOutput and asserts that it matches want. A single leading newline
+// in want is ignored, so expectations can start on their own line inside a
+// raw string literal. Any secrets passed in
+// are served to the renderer the same way the SecretLoader mounts them at
+// runtime, so Secret references in the output spec resolve to their mounted
+// path rather than being left unresolved.
+func CheckConfigForOutput(t *testing.T, o v1beta1.SyslogNGOutput, want string, secrets ...corev1.Secret) {
+	t.Helper()
+
+	var mountSecrets secret.MountSecrets
+	loader := secret.NewSecretLoader(&fakeSecretReader{secrets: secrets}, o.Namespace, "/etc/syslog-ng/secret", &mountSecrets)
+
+	got, err := renderDestination(o, loader)
+	require.NoError(t, err)
+	require.Equal(t, strings.TrimPrefix(want, "\n"), got)
+}
+
+// CheckConfigCompiles wraps snippet in a minimal config using the same
+// version pin as the rest of the test suite (`@version: 3.37`) and checks
+// that syslog-ng accepts it with `--syntax-only`. It skips the check if
+// syslog-ng isn't installed, since the binary isn't available in every
+// environment these tests run in.
+func CheckConfigCompiles(t *testing.T, snippet string) {
+	t.Helper()
+
+	bin, err := exec.LookPath("syslog-ng")
+	if err != nil {
+		t.Skip("syslog-ng binary not found, skipping config compile check")
+	}
+
+	cfg := "@version: 3.37\n" + snippet
+
+	f, err := os.CreateTemp(t.TempDir(), "*.conf")
+	require.NoError(t, err)
+	_, err = f.WriteString(cfg)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	out, err := exec.Command(bin, "--syntax-only", "-f", filepath.Clean(f.Name())).CombinedOutput()
+	require.NoError(t, err, "syslog-ng --syntax-only rejected generated config:\n%s\n%s", cfg, out)
+}
+
+type fakeSecretReader struct {
+	secrets []corev1.Secret
+}
+
+func (r *fakeSecretReader) Get(_ context.Context, key client.ObjectKey, obj client.Object) error {
+	secretObj, ok := obj.(*corev1.Secret)
+	if !ok {
+		return apierrors.NewNotFound(schema.GroupResource{
+			Group:    obj.GetObjectKind().GroupVersionKind().Group,
+			Resource: strings.ToLower(obj.GetObjectKind().GroupVersionKind().Kind),
+		}, key.String())
+	}
+
+	for _, s := range r.secrets {
+		if s.Namespace == key.Namespace && s.Name == key.Name {
+			*secretObj = s
+			return nil
+		}
+	}
+	return apierrors.NewNotFound(corev1.Resource("secret"), key.String())
+}
+
+func (r *fakeSecretReader) List(_ context.Context, _ client.ObjectList, _ ...client.ListOption) error {
+	panic("not implemented")
+}
+
+var _ client.Reader = (*fakeSecretReader)(nil)
@@ -0,0 +1,182 @@
+// Copyright © 2019 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"testing"
+
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+	"github.com/kube-logging/logging-operator/pkg/sdk/logging/api/v1beta1"
+	"github.com/kube-logging/logging-operator/pkg/sdk/logging/model/syslogng/config"
+	"github.com/kube-logging/logging-operator/pkg/sdk/logging/model/syslogng/output"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMongoDBOutputAuthTLS(t *testing.T) {
+	config.CheckConfigForOutput(t,
+		v1beta1.SyslogNGOutput{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "test-mongodb-out",
+			},
+			Spec: v1beta1.SyslogNGOutputSpec{
+				MongoDB: &output.MongoDB{
+					Collection: "syslog",
+					Uri:        "mongodb://mongodb.default.svc.cluster.local:27017/syslog",
+					Auth: &output.MongoDBAuth{
+						Username: "syslog-ng",
+						Password: &secret.Secret{
+							MountFrom: &secret.ValueFrom{
+								SecretKeyRef: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "mongodb-secret",
+									},
+									Key: "password",
+								},
+							},
+						},
+						AuthSource:    "admin",
+						AuthMechanism: "SCRAM-SHA-256",
+					},
+					TLS: &output.MongoDBTLS{
+						CAFile: &secret.Secret{
+							MountFrom: &secret.ValueFrom{
+								SecretKeyRef: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "mongodb-secret",
+									},
+									Key: "ca.crt",
+								},
+							},
+						},
+						PeerVerify: true,
+					},
+				},
+			},
+		},
+		`
+destination "output_default_test-mongodb-out" {
+	mongodb("mongodb://mongodb.default.svc.cluster.local:27017/syslog" collection("syslog") tls(ca_file("/etc/syslog-ng/secret/default-mongodb-secret-ca.crt") peer_verify(yes)) auth(username("syslog-ng") password("/etc/syslog-ng/secret/default-mongodb-secret-password") auth_source("admin") auth_mechanism("SCRAM-SHA-256")) persist_name("output_default_test-mongodb-out"));
+};
+`,
+		corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "mongodb-secret",
+			},
+			Data: map[string][]byte{
+				"password": []byte("s3cr3t"),
+				"ca.crt":   []byte("-----BEGIN CERTIFICATE-----"),
+			},
+		},
+	)
+}
+
+func TestMongoDBOutputFullFields(t *testing.T) {
+	bulk := true
+	bulkBypassValidation := false
+	config.CheckConfigForOutput(t,
+		v1beta1.SyslogNGOutput{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "test-mongodb-out",
+			},
+			Spec: v1beta1.SyslogNGOutputSpec{
+				MongoDB: &output.MongoDB{
+					Collection: "syslog",
+					Uri:        "mongodb://mongodb.default.svc.cluster.local:27017/syslog",
+					ValuePairs: output.ValuePairs{
+						Scope: output.RawString{String: `"selected-macros" "nv-pairs"`},
+					},
+					Dir:        "/buffers",
+					Compaction: true,
+					DiskBuffer: &output.DiskBuffer{
+						DiskBufSize: 1048576,
+					},
+					Batch: output.Batch{
+						BatchLines: 1000,
+					},
+					Bulk: output.Bulk{
+						Bulk:                 &bulk,
+						BulkByPassValidation: &bulkBypassValidation,
+					},
+				},
+			},
+		},
+		`
+destination "output_default_test-mongodb-out" {
+	mongodb("mongodb://mongodb.default.svc.cluster.local:27017/syslog" collection("syslog") value_pairs(scope("selected-macros" "nv-pairs")) dir("/buffers") compaction(yes) disk_buffer(disk_buf_size(1048576)) batch_lines(1000) bulk(yes) bulk_bypass_validation(no) persist_name("output_default_test-mongodb-out"));
+};
+`,
+	)
+}
+
+func TestMongoDBOutputCollectionTemplate(t *testing.T) {
+	want := `
+destination "output_default_test-mongodb-out" {
+	mongodb("mongodb://mongodb.default.svc.cluster.local:27017/syslog" collection("logs_${json.kubernetes.namespace_name}_${YEAR}${MONTH}") persist_name("output_default_test-mongodb-out"));
+};
+`
+	config.CheckConfigForOutput(t,
+		v1beta1.SyslogNGOutput{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "test-mongodb-out",
+			},
+			Spec: v1beta1.SyslogNGOutputSpec{
+				MongoDB: &output.MongoDB{
+					Uri:                "mongodb://mongodb.default.svc.cluster.local:27017/syslog",
+					CollectionTemplate: "logs_${json.kubernetes.namespace_name}_${YEAR}${MONTH}",
+				},
+			},
+		},
+		want,
+	)
+	config.CheckConfigCompiles(t, want)
+}
+
+// TestMongoDBOutputCollectionTemplateWithBatch documents the supported way to
+// keep per-tenant routing batch-safe: cap BatchLines instead of enabling
+// Bulk, since syslog-ng does not re-flush a batch when the templated
+// collection name changes mid-batch. Combining CollectionTemplate with Bulk
+// is rejected by the MongoDB CRD's XValidation rule rather than by this
+// renderer.
+func TestMongoDBOutputCollectionTemplateWithBatch(t *testing.T) {
+	want := `
+destination "output_default_test-mongodb-out" {
+	mongodb("mongodb://mongodb.default.svc.cluster.local:27017/syslog" collection("logs_${json.kubernetes.namespace_name}_${YEAR}${MONTH}") batch_lines(1) persist_name("output_default_test-mongodb-out"));
+};
+`
+	config.CheckConfigForOutput(t,
+		v1beta1.SyslogNGOutput{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "test-mongodb-out",
+			},
+			Spec: v1beta1.SyslogNGOutputSpec{
+				MongoDB: &output.MongoDB{
+					Uri:                "mongodb://mongodb.default.svc.cluster.local:27017/syslog",
+					CollectionTemplate: "logs_${json.kubernetes.namespace_name}_${YEAR}${MONTH}",
+					Batch: output.Batch{
+						BatchLines: 1,
+					},
+				},
+			},
+		},
+		want,
+	)
+	config.CheckConfigCompiles(t, want)
+}
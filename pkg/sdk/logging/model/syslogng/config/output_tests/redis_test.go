@@ -17,8 +17,11 @@ package test
 import (
 	"testing"
 
+	"github.com/banzaicloud/operator-tools/pkg/secret"
 	"github.com/kube-logging/logging-operator/pkg/sdk/logging/api/v1beta1"
 	"github.com/kube-logging/logging-operator/pkg/sdk/logging/model/syslogng/config"
+	"github.com/kube-logging/logging-operator/pkg/sdk/logging/model/syslogng/output"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -29,12 +32,72 @@ func TestRedisOutput(t *testing.T) {
 				Namespace: "default",
 				Name:      "test-redis-out",
 			},
-			Spec: v1beta1.SyslogNGOutputSpec{},
+			Spec: v1beta1.SyslogNGOutputSpec{
+				Redis: &output.Redis{
+					Host: "redis.default.svc.cluster.local",
+					Port: 6379,
+					Auth: &secret.Secret{
+						MountFrom: &secret.ValueFrom{
+							SecretKeyRef: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{
+									Name: "redis-secret",
+								},
+								Key: "password",
+							},
+						},
+					},
+					Command:     "LPUSH",
+					CommandArgs: []string{"${HOST}", "${MESSAGE}"},
+					PersistName: "redis-out",
+				},
+			},
 		},
 		`
 destination "output_default_test-redis-out" {
+	redis("redis.default.svc.cluster.local" port(6379) auth("/etc/syslog-ng/secret/default-redis-secret-password") command("LPUSH") command-args("${HOST}" "${MESSAGE}") persist_name("redis-out"));
+};
+`,
+		corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "redis-secret",
+			},
+			Data: map[string][]byte{
+				"password": []byte("s3cr3t"),
+			},
+		},
+	)
+}
 
+func TestRedisOutputBatchAndDiskBuffer(t *testing.T) {
+	reliable := true
+	config.CheckConfigForOutput(t,
+		v1beta1.SyslogNGOutput{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "test-redis-out",
+			},
+			Spec: v1beta1.SyslogNGOutputSpec{
+				Redis: &output.Redis{
+					Host:        "redis.default.svc.cluster.local",
+					Command:     "LPUSH",
+					CommandArgs: []string{"${HOST}", "${MESSAGE}"},
+					DiskBuffer: &output.DiskBuffer{
+						Reliable:    &reliable,
+						DiskBufSize: 1048576,
+						Dir:         "/buffers",
+					},
+					Batch: output.Batch{
+						BatchLines:   1000,
+						BatchTimeout: 5000,
+					},
+				},
+			},
+		},
+		`
+destination "output_default_test-redis-out" {
+	redis("redis.default.svc.cluster.local" command("LPUSH") command-args("${HOST}" "${MESSAGE}") disk_buffer(reliable(yes) disk_buf_size(1048576) dir("/buffers")) batch_lines(1000) batch_timeout(5000));
 };
 `,
 	)
-}
\ No newline at end of file
+}
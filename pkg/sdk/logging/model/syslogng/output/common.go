@@ -0,0 +1,96 @@
+// Copyright © 2023 Cisco Systems, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"fmt"
+	"strings"
+)
+
+// +kubebuilder:object:generate=true
+// Batch is a set of options available for destination drivers that batch
+// messages before sending them. See the [Syslog-ng Batch options](../batch/) for details.
+type Batch struct {
+	// Sets the number of messages that are sent in a single batch. (default: 1)
+	BatchLines int `json:"batch_lines,omitempty"`
+	// Sets the time syslog-ng OSE waits for the batch to fill up, in milliseconds. If the batch is not filled by this time, it is sent anyway. (default: 0, disabled)
+	BatchTimeout int `json:"batch_timeout,omitempty"`
+	// Sets the maximum size of a batch in bytes. (default: 0, disabled)
+	BatchBytes int `json:"batch_bytes,omitempty"`
+}
+
+func (b Batch) render() []string {
+	var parts []string
+	if b.BatchLines != 0 {
+		parts = append(parts, fmt.Sprintf("batch_lines(%d)", b.BatchLines))
+	}
+	if b.BatchTimeout != 0 {
+		parts = append(parts, fmt.Sprintf("batch_timeout(%d)", b.BatchTimeout))
+	}
+	if b.BatchBytes != 0 {
+		parts = append(parts, fmt.Sprintf("batch_bytes(%d)", b.BatchBytes))
+	}
+	return parts
+}
+
+// +kubebuilder:object:generate=true
+// DiskBuffer configures the disk-buffer of a destination, which syslog-ng
+// OSE uses to avoid losing messages if the destination becomes unavailable.
+// See the [Syslog-ng DiskBuffer options](../disk_buffer/) for details.
+type DiskBuffer struct {
+	// If set to yes, syslog-ng OSE cannot lose logs in case of reload/restart, unreachable destination or syslog-ng OSE crash. This solution provides a slower, but reliable disk-buffer option. (default: false)
+	Reliable *bool `json:"reliable,omitempty"`
+	// The maximum size of the disk-buffer in bytes. (default: 1048576)
+	DiskBufSize int `json:"disk_buf_size,omitempty"`
+	// Maximum number of messages stored in the output buffer of the destination. (default: 10000)
+	MemBufLength int `json:"mem_buf_length,omitempty"`
+	// Defines the folder where the disk-buffer files are stored.
+	Dir string `json:"dir,omitempty"`
+}
+
+func (d *DiskBuffer) render() []string {
+	if d == nil {
+		return nil
+	}
+	var parts []string
+	if d.Reliable != nil {
+		parts = append(parts, fmt.Sprintf("reliable(%s)", yesno(*d.Reliable)))
+	}
+	if d.DiskBufSize != 0 {
+		parts = append(parts, fmt.Sprintf("disk_buf_size(%d)", d.DiskBufSize))
+	}
+	if d.MemBufLength != 0 {
+		parts = append(parts, fmt.Sprintf("mem_buf_length(%d)", d.MemBufLength))
+	}
+	if d.Dir != "" {
+		parts = append(parts, fmt.Sprintf("dir(%q)", d.Dir))
+	}
+	return parts
+}
+
+func yesno(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+func joinOptions(groups ...[]string) string {
+	var all []string
+	for _, g := range groups {
+		all = append(all, g...)
+	}
+	return strings.Join(all, " ")
+}
@@ -14,6 +14,13 @@
 
 package output
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+)
+
 // +name:"MongoDB"
 // +weight:"200"
 type _hugoMongoDB interface{} //nolint:deadcode,unused
@@ -35,9 +42,24 @@ type _hugoMongoDB interface{} //nolint:deadcode,unused
 // spec:
 //
 //	mongodb:
-//	  collection: syslog
+//	  collection_template: "logs_${json.kubernetes.namespace_name}_${YEAR}${MONTH}"
 //	  uri: mongodb://127.0.0.1:27017/syslog?wtimeoutMS=60000&socketTimeoutMS=60000&connectTimeoutMS=60000
 //	  value_pairs: scope("selected-macros" "nv-pairs")
+//	  auth:
+//	    username: syslog-ng
+//	    password:
+//	      mountFrom:
+//	        secretKeyRef:
+//	          name: mongodb-secret
+//	          key: password
+//	    auth_source: admin
+//	    auth_mechanism: SCRAM-SHA-256
+//	  tls:
+//	    ca_file:
+//	      mountFrom:
+//	        secretKeyRef:
+//	          name: mongodb-secret
+//	          key: ca.crt
 //
 // {{</ highlight >}}
 type _docMongoDB interface{} //nolint:deadcode,unused
@@ -49,9 +71,22 @@ type _docMongoDB interface{} //nolint:deadcode,unused
 type _metaMongoDB interface{} //nolint:deadcode,unused
 
 // +kubebuilder:object:generate=true
+// +kubebuilder:validation:XValidation:rule="!has(self.collection) || !has(self.collection_template)",message="collection and collection_template are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!has(self.collection_template) || !has(self.bulk) || !self.bulk",message="bulk cannot be enabled together with collection_template: syslog-ng does not flush a batch when the templated collection name changes mid-batch, so a bulk write could span multiple collections"
 type MongoDB struct {
-	//  The name of the MongoDB collection where the log messages are stored (collections are similar to SQL tables). Note that the name of the collection must not start with a dollar sign ($), and that it may contain dot (.) characters.
-	Collection string `json:"collection"`
+	//  The name of the MongoDB collection where the log messages are stored (collections are similar to SQL tables). Note that the name of the collection must not start with a dollar sign ($), and that it may contain dot (.) characters. Mutually exclusive with `CollectionTemplate`.
+	// +kubebuilder:validation:Pattern=`^[^$].*$`
+	Collection string `json:"collection,omitempty"`
+	// A syslog-ng template string that is expanded to determine the name of the MongoDB collection where a given log message is stored, for example, `"logs_${json.kubernetes.namespace_name}_${YEAR}${MONTH}"`. This allows routing messages from different tenants or time periods into different collections. Mutually exclusive with `Collection`, and, like `Collection`, must not start with a dollar sign ($).
+	//
+	// syslog-ng does not re-flush a batch when the templated collection name
+	// changes partway through it, so CRD validation rejects setting `Bulk` to
+	// true together with this field — a bulk write could otherwise be split
+	// across collections. If `Bulk` is unset, no bulk() option is rendered and
+	// syslog-ng's own bulk default applies. Keep `BatchLines`/`BatchBytes` small if messages
+	// routed to different collections need to stay in separate writes.
+	// +kubebuilder:validation:Pattern=`^[^$].*$`
+	CollectionTemplate string `json:"collection_template,omitempty"`
 	//  If set to yes, syslog-ng OSE cannot lose logs in case of reload/restart, unreachable destination or syslog-ng OSE crash. This solution provides a slower, but reliable disk-buffer option.
 	Compaction bool `json:"compaction"`
 	// Defines the folder where the disk-buffer files are stored.
@@ -62,12 +97,162 @@ type MongoDB struct {
 	Uri string `json:"uri,omitempty"`
 	// Creates structured name-value pairs from the data and metadata of the log message. (default: "scope("selected-macros" "nv-pairs")")
 	ValuePairs ValuePairs `json:"value_pairs,omitempty"`
+	// Authentication settings used to log in to the MongoDB server. If you set this, do not include credentials in `Uri`.
+	Auth *MongoDBAuth `json:"auth,omitempty"`
+	// TLS settings used when connecting to the MongoDB server.
+	TLS *MongoDBTLS `json:"tls,omitempty"`
 	// Batching parameters
 	Batch `json:",inline"`
 	// Bulk operation related options
 	Bulk `json:",inline"`
 }
 
+// +kubebuilder:object:generate=true
+// Authentication settings for the MongoDB output. See the [MongoDB driver documentation](https://www.mongodb.com/docs/manual/core/authentication/) for details on the supported mechanisms.
+type MongoDBAuth struct {
+	// The name of the user used to authenticate on the MongoDB server.
+	Username string `json:"username,omitempty"`
+	// The password used to authenticate on the MongoDB server.
+	Password *secret.Secret `json:"password,omitempty"`
+	// The name of the database to authenticate against. (default: "admin")
+	AuthSource string `json:"auth_source,omitempty"`
+	// The authentication mechanism to use. (default: SCRAM-SHA-256)
+	// +kubebuilder:validation:Enum=SCRAM-SHA-1;SCRAM-SHA-256;MONGODB-X509
+	AuthMechanism string `json:"auth_mechanism,omitempty"`
+}
+
+// Render renders the `mongodb(...)` driver call for this destination. The
+// collection() option accepts a template string directly, so CollectionTemplate
+// is rendered the same way as a literal Collection name. An earlier version of
+// this renderer tried to guard bulk writes against mid-batch collection
+// changes by emitting a named template() preamble plus a
+// flush_on_collection_change() option, but neither is valid syslog-ng syntax;
+// the mutual exclusivity of CollectionTemplate and Bulk is enforced instead
+// via CRD validation (see the MongoDB struct's XValidation rules).
+func (m *MongoDB) Render(secretLoader secret.SecretLoader, destName string) (driver string, err error) {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%q", m.Uri))
+
+	switch {
+	case m.CollectionTemplate != "":
+		sb.WriteString(fmt.Sprintf(" collection(%q)", m.CollectionTemplate))
+	case m.Collection != "":
+		sb.WriteString(fmt.Sprintf(" collection(%q)", m.Collection))
+	}
+
+	if opts := m.ValuePairs.render(); len(opts) > 0 {
+		sb.WriteString(fmt.Sprintf(" value_pairs(%s)", joinOptions(opts)))
+	}
+
+	if m.TLS != nil {
+		opts, err := m.TLS.render(secretLoader)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(fmt.Sprintf(" tls(%s)", opts))
+	}
+
+	if m.Auth != nil {
+		opts, err := m.Auth.render(secretLoader)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(fmt.Sprintf(" auth(%s)", opts))
+	}
+
+	if m.Dir != "" {
+		sb.WriteString(fmt.Sprintf(" dir(%q)", m.Dir))
+	}
+
+	if m.Compaction {
+		sb.WriteString(" compaction(yes)")
+	}
+
+	if opts := m.DiskBuffer.render(); len(opts) > 0 {
+		sb.WriteString(fmt.Sprintf(" disk_buffer(%s)", joinOptions(opts)))
+	}
+
+	if opts := m.Batch.render(); len(opts) > 0 {
+		sb.WriteString(fmt.Sprintf(" %s", joinOptions(opts)))
+	}
+
+	if opts := m.Bulk.render(); len(opts) > 0 {
+		sb.WriteString(fmt.Sprintf(" %s", joinOptions(opts)))
+	}
+
+	sb.WriteString(fmt.Sprintf(" persist_name(%q)", destName))
+
+	return fmt.Sprintf("mongodb(%s)", sb.String()), nil
+}
+
+func (a *MongoDBAuth) render(secretLoader secret.SecretLoader) (string, error) {
+	var parts []string
+	if a.Username != "" {
+		parts = append(parts, fmt.Sprintf("username(%q)", a.Username))
+	}
+	if a.Password != nil {
+		path, err := secretLoader.Load(a.Password)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, fmt.Sprintf("password(%q)", path))
+	}
+	if a.AuthSource != "" {
+		parts = append(parts, fmt.Sprintf("auth_source(%q)", a.AuthSource))
+	}
+	if a.AuthMechanism != "" {
+		parts = append(parts, fmt.Sprintf("auth_mechanism(%q)", a.AuthMechanism))
+	}
+	return strings.Join(parts, " "), nil
+}
+
+// +kubebuilder:object:generate=true
+// TLS settings for the MongoDB output.
+type MongoDBTLS struct {
+	// The CA certificate used to validate the MongoDB server's certificate.
+	CAFile *secret.Secret `json:"ca_file,omitempty"`
+	// The certificate syslog-ng OSE uses to authenticate itself to the MongoDB server.
+	CertFile *secret.Secret `json:"cert_file,omitempty"`
+	// The private key of the certificate set in `CertFile`.
+	KeyFile *secret.Secret `json:"key_file,omitempty"`
+	// If set to true, syslog-ng OSE does not verify that the hostname of the MongoDB server matches the one in its certificate. (default: false)
+	AllowInvalidHostname bool `json:"allow_invalid_hostname,omitempty"`
+	// If set to true, syslog-ng OSE verifies the MongoDB server's certificate (renders peer_verify(yes)). When false, no peer_verify() option is rendered. (default: false)
+	PeerVerify bool `json:"peer_verify,omitempty"`
+}
+
+func (t *MongoDBTLS) render(secretLoader secret.SecretLoader) (string, error) {
+	var parts []string
+	if t.CAFile != nil {
+		path, err := secretLoader.Load(t.CAFile)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, fmt.Sprintf("ca_file(%q)", path))
+	}
+	if t.CertFile != nil {
+		path, err := secretLoader.Load(t.CertFile)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, fmt.Sprintf("cert_file(%q)", path))
+	}
+	if t.KeyFile != nil {
+		path, err := secretLoader.Load(t.KeyFile)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, fmt.Sprintf("key_file(%q)", path))
+	}
+	if t.AllowInvalidHostname {
+		parts = append(parts, "allow_invalid_hostname(yes)")
+	}
+	if t.PeerVerify {
+		parts = append(parts, "peer_verify(yes)")
+	}
+	return strings.Join(parts, " "), nil
+}
+
 // +kubebuilder:object:generate=true
 // Bulk operation related options
 type Bulk struct {
@@ -78,6 +263,17 @@ type Bulk struct {
 	BulkByPassValidation *bool `json:"bulk_bypass_validation,omitempty"`
 }
 
+func (b Bulk) render() []string {
+	var parts []string
+	if b.Bulk != nil {
+		parts = append(parts, fmt.Sprintf("bulk(%s)", yesno(*b.Bulk)))
+	}
+	if b.BulkByPassValidation != nil {
+		parts = append(parts, fmt.Sprintf("bulk_bypass_validation(%s)", yesno(*b.BulkByPassValidation)))
+	}
+	return parts
+}
+
 // +kubebuilder:object:generate=true
 // TODO move this to a common module once it is used in more places
 type ValuePairs struct {
@@ -87,7 +283,24 @@ type ValuePairs struct {
 	Pair    RawString `json:"pair,omitempty"`
 }
 
+func (v ValuePairs) render() []string {
+	var parts []string
+	if v.Scope.String != "" {
+		parts = append(parts, fmt.Sprintf("scope(%s)", v.Scope.String))
+	}
+	if v.Exclude.String != "" {
+		parts = append(parts, fmt.Sprintf("exclude(%s)", v.Exclude.String))
+	}
+	if v.Key.String != "" {
+		parts = append(parts, fmt.Sprintf("key(%s)", v.Key.String))
+	}
+	if v.Pair.String != "" {
+		parts = append(parts, fmt.Sprintf("pair(%s)", v.Pair.String))
+	}
+	return parts
+}
+
 // +kubebuilder:object:generate=true
 type RawString struct {
 	String string `json:"raw_string,omitempty"`
-}
\ No newline at end of file
+}
@@ -0,0 +1,123 @@
+// Copyright © 2023 Cisco Systems, Inc. and/or its affiliates
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/banzaicloud/operator-tools/pkg/secret"
+)
+
+// +name:"Redis"
+// +weight:"200"
+type _hugoRedis interface{} //nolint:deadcode,unused
+
+// +docName:"Sending messages from a local network to a Redis database"
+//
+// ## Prerequisites
+//
+// ## Example
+//
+// {{< highlight yaml >}}
+// apiVersion: logging.banzaicloud.io/v1beta1
+// kind: SyslogNGOutput
+// metadata:
+//
+//	name: redis
+//	namespace: default
+//
+// spec:
+//
+//	redis:
+//	  host: redis.default.svc.cluster.local
+//	  port: 6379
+//	  command: LPUSH
+//	  command-args:
+//	    - "${HOST}"
+//	    - "${MESSAGE}"
+//
+// {{</ highlight >}}
+type _docRedis interface{} //nolint:deadcode,unused
+
+// +name:"Redis Destination"
+// +url:"https://www.syslog-ng.com/technical-documents/doc/syslog-ng-open-source-edition/3.37/administration-guide/41#TOPIC-1829156"
+// +description:"Sending messages into Redis"
+// +status:"Testing"
+type _metaRedis interface{} //nolint:deadcode,unused
+
+// +kubebuilder:object:generate=true
+type Redis struct {
+	// Specifies the hostname or IP address of the Redis server.
+	Host string `json:"host"`
+	// Specifies the port number of the Redis server. (default: 6379)
+	Port int `json:"port,omitempty"`
+	// The password used for authentication on a password-protected Redis server.
+	Auth *secret.Secret `json:"auth,omitempty"`
+	// Specifies the Redis command to use to send the message, for example, LPUSH, RPUSH, SET or PUBLISH.
+	Command string `json:"command"`
+	// The arguments of the Redis command. The message and its parts can be referenced using the standard syslog-ng macros and templates, for example, ${HOST} or ${MESSAGE}.
+	CommandArgs []string `json:"command-args"`
+	// Specifies the name of the persist file where syslog-ng OSE stores data about the destination. (default: unique hash)
+	PersistName string `json:"persist_name,omitempty"`
+	// This option enables putting outgoing messages into the disk buffer of the destination to avoid message loss in case of a system failure on the destination side. For details, see the [Syslog-ng DiskBuffer options](../disk_buffer/). (default: false)
+	DiskBuffer *DiskBuffer `json:"disk_buffer,omitempty"`
+	// Batching parameters
+	Batch `json:",inline"`
+}
+
+// Render renders the `redis(...)` driver call for this destination. destName
+// is unused here; it only exists to satisfy the destinationDriver interface
+// shared with other destinations.
+func (r *Redis) Render(secretLoader secret.SecretLoader, destName string) (driver string, err error) {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%q", r.Host))
+
+	if r.Port != 0 {
+		sb.WriteString(fmt.Sprintf(" port(%d)", r.Port))
+	}
+
+	if r.Auth != nil {
+		path, err := secretLoader.Load(r.Auth)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(fmt.Sprintf(" auth(%q)", path))
+	}
+
+	sb.WriteString(fmt.Sprintf(" command(%q)", r.Command))
+
+	if len(r.CommandArgs) > 0 {
+		args := make([]string, len(r.CommandArgs))
+		for i, a := range r.CommandArgs {
+			args[i] = fmt.Sprintf("%q", a)
+		}
+		sb.WriteString(fmt.Sprintf(" command-args(%s)", strings.Join(args, " ")))
+	}
+
+	if r.PersistName != "" {
+		sb.WriteString(fmt.Sprintf(" persist_name(%q)", r.PersistName))
+	}
+
+	if opts := r.DiskBuffer.render(); len(opts) > 0 {
+		sb.WriteString(fmt.Sprintf(" disk_buffer(%s)", joinOptions(opts)))
+	}
+
+	if opts := r.Batch.render(); len(opts) > 0 {
+		sb.WriteString(fmt.Sprintf(" %s", joinOptions(opts)))
+	}
+
+	return fmt.Sprintf("redis(%s)", sb.String()), nil
+}